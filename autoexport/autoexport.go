@@ -0,0 +1,247 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package autoexport exposes an ExporterFactory whose concrete destination is
+// resolved at build time from the OTEL_TRACES_EXPORTER, OTEL_METRICS_EXPORTER
+// and OTEL_LOGS_EXPORTER environment variables, rather than from the
+// collector config file. This lets operators swap exporters (for example
+// between otlp and otlphttp) without editing the pipeline definitions.
+package autoexport // import "go.opentelemetry.io/collector/autoexport"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+)
+
+// typeStr is the component.Type under which the autoexport exporter itself is
+// registered in a collector config; it never appears in OTEL_*_EXPORTER.
+const typeStr = "auto"
+
+const (
+	envTracesExporter  = "OTEL_TRACES_EXPORTER"
+	envMetricsExporter = "OTEL_METRICS_EXPORTER"
+	envLogsExporter    = "OTEL_LOGS_EXPORTER"
+
+	// defaultExporterName is used for a signal whose environment variable is unset.
+	defaultExporterName = "otlp"
+
+	// noneExporterName disables the signal entirely; ConsumeX is a no-op.
+	noneExporterName = "none"
+)
+
+// ErrExporterNotRegistered is returned when the exporter named through the
+// environment has not been made available via RegisterAutoExporter for the
+// requested signal, and no WithFallback option was configured.
+type ErrExporterNotRegistered struct {
+	Name string
+	Kind config.DataType
+}
+
+func (e *ErrExporterNotRegistered) Error() string {
+	return fmt.Sprintf("no %q exporter registered for %s; call autoexport.RegisterAutoExporter during init or set %s to a registered value",
+		e.Name, e.Kind, envVarForKind(e.Kind))
+}
+
+func envVarForKind(kind config.DataType) string {
+	switch kind {
+	case config.TracesDataType:
+		return envTracesExporter
+	case config.MetricsDataType:
+		return envMetricsExporter
+	case config.LogsDataType:
+		return envLogsExporter
+	default:
+		return ""
+	}
+}
+
+type registryKey struct {
+	name string
+	kind config.DataType
+}
+
+var (
+	registryMu sync.Mutex
+	registry   = map[registryKey]component.ExporterFactory{}
+)
+
+// RegisterAutoExporter makes an ExporterFactory selectable, under the given
+// name, by the OTEL_TRACES_EXPORTER/OTEL_METRICS_EXPORTER/OTEL_LOGS_EXPORTER
+// environment variables for the given signal. It is typically called from an
+// init() function of the package providing the underlying exporter.
+func RegisterAutoExporter(name string, kind config.DataType, f component.ExporterFactory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[registryKey{name: name, kind: kind}] = f
+}
+
+func lookupAutoExporter(name string, kind config.DataType) (component.ExporterFactory, bool) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	f, ok := registry[registryKey{name: name, kind: kind}]
+	return f, ok
+}
+
+// Option customizes the behavior of NewAutoExporterFactory.
+type Option interface {
+	apply(*options)
+}
+
+type optionFunc func(*options)
+
+func (f optionFunc) apply(o *options) {
+	f(o)
+}
+
+type options struct {
+	fallback component.ExporterFactory
+}
+
+// WithFallback sets the ExporterFactory used for a signal when the exporter
+// named by its environment variable has not been registered, instead of
+// returning ErrExporterNotRegistered.
+func WithFallback(f component.ExporterFactory) Option {
+	return optionFunc(func(o *options) {
+		o.fallback = f
+	})
+}
+
+// Config is the configuration for the autoexport exporter. For each signal it
+// holds the exporter name resolved from the environment, the concrete exporter
+// factory found for that name, and the default config of that factory.
+type Config struct {
+	config.ExporterSettings `mapstructure:",squash"`
+
+	signal [3]resolvedSignal
+}
+
+type resolvedSignal struct {
+	name    string
+	kind    config.DataType
+	factory component.ExporterFactory
+	cfg     config.Exporter
+}
+
+func signalIndex(kind config.DataType) int {
+	switch kind {
+	case config.TracesDataType:
+		return 0
+	case config.MetricsDataType:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func resolve(o options, kind config.DataType) resolvedSignal {
+	name := strings.TrimSpace(os.Getenv(envVarForKind(kind)))
+	if name == "" {
+		name = defaultExporterName
+	}
+
+	rs := resolvedSignal{name: name, kind: kind}
+	if name == noneExporterName {
+		return rs
+	}
+
+	f, ok := lookupAutoExporter(name, kind)
+	if !ok {
+		f = o.fallback
+	}
+	rs.factory = f
+	if f != nil {
+		rs.cfg = f.CreateDefaultConfig()
+	}
+	return rs
+}
+
+// NewAutoExporterFactory returns an ExporterFactory that, for each signal,
+// delegates to the concrete ExporterFactory selected by the corresponding
+// OTEL_*_EXPORTER environment variable.
+func NewAutoExporterFactory(opts ...Option) component.ExporterFactory {
+	var o options
+	for _, opt := range opts {
+		opt.apply(&o)
+	}
+
+	return component.NewExporterFactory(
+		typeStr,
+		func() config.Exporter {
+			cfg := &Config{
+				ExporterSettings: config.NewExporterSettings(config.NewComponentID(typeStr)),
+			}
+			cfg.signal[signalIndex(config.TracesDataType)] = resolve(o, config.TracesDataType)
+			cfg.signal[signalIndex(config.MetricsDataType)] = resolve(o, config.MetricsDataType)
+			cfg.signal[signalIndex(config.LogsDataType)] = resolve(o, config.LogsDataType)
+			return cfg
+		},
+		component.WithTracesExporter(createTracesExporter, component.StabilityLevelBeta),
+		component.WithMetricsExporter(createMetricsExporter, component.StabilityLevelBeta),
+		component.WithLogsExporter(createLogsExporter, component.StabilityLevelBeta),
+	)
+}
+
+func resolvedFor(cfg config.Exporter, kind config.DataType) (resolvedSignal, error) {
+	c, ok := cfg.(*Config)
+	if !ok {
+		return resolvedSignal{}, fmt.Errorf("autoexport: invalid config type %T", cfg)
+	}
+	rs := c.signal[signalIndex(kind)]
+	if rs.name == noneExporterName {
+		return rs, nil
+	}
+	if rs.factory == nil {
+		return resolvedSignal{}, &ErrExporterNotRegistered{Name: rs.name, Kind: kind}
+	}
+	return rs, nil
+}
+
+func createTracesExporter(ctx context.Context, set component.ExporterCreateSettings, cfg config.Exporter) (component.TracesExporter, error) {
+	rs, err := resolvedFor(cfg, config.TracesDataType)
+	if err != nil {
+		return nil, err
+	}
+	if rs.factory == nil {
+		return newNopExporter(), nil
+	}
+	return rs.factory.CreateTracesExporter(ctx, set, rs.cfg)
+}
+
+func createMetricsExporter(ctx context.Context, set component.ExporterCreateSettings, cfg config.Exporter) (component.MetricsExporter, error) {
+	rs, err := resolvedFor(cfg, config.MetricsDataType)
+	if err != nil {
+		return nil, err
+	}
+	if rs.factory == nil {
+		return newNopExporter(), nil
+	}
+	return rs.factory.CreateMetricsExporter(ctx, set, rs.cfg)
+}
+
+func createLogsExporter(ctx context.Context, set component.ExporterCreateSettings, cfg config.Exporter) (component.LogsExporter, error) {
+	rs, err := resolvedFor(cfg, config.LogsDataType)
+	if err != nil {
+		return nil, err
+	}
+	if rs.factory == nil {
+		return newNopExporter(), nil
+	}
+	return rs.factory.CreateLogsExporter(ctx, set, rs.cfg)
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoexport
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+)
+
+type fakeExporterConfig struct {
+	id config.ComponentID
+}
+
+func (c fakeExporterConfig) ID() config.ComponentID { return c.id }
+
+func (c fakeExporterConfig) Validate() error { return nil }
+
+func newFakeExporterFactory(cfgType config.Type, createTraces component.CreateTracesExporterFunc) component.ExporterFactory {
+	return component.NewExporterFactory(
+		cfgType,
+		func() config.Exporter { return fakeExporterConfig{id: config.NewComponentID(cfgType)} },
+		component.WithTracesExporter(createTraces, component.StabilityLevelBeta),
+	)
+}
+
+func TestAutoExporterFactoryNone(t *testing.T) {
+	t.Setenv(envTracesExporter, noneExporterName)
+
+	factory := NewAutoExporterFactory()
+	exp, err := factory.CreateTracesExporter(context.Background(), component.ExporterCreateSettings{}, factory.CreateDefaultConfig())
+	require.NoError(t, err)
+	assert.IsType(t, nopExporter{}, exp)
+}
+
+func TestAutoExporterFactoryUnregisteredNameErrors(t *testing.T) {
+	t.Setenv(envTracesExporter, "does-not-exist")
+
+	factory := NewAutoExporterFactory()
+	_, err := factory.CreateTracesExporter(context.Background(), component.ExporterCreateSettings{}, factory.CreateDefaultConfig())
+	require.Error(t, err)
+	var notRegistered *ErrExporterNotRegistered
+	require.ErrorAs(t, err, &notRegistered)
+	assert.Equal(t, "does-not-exist", notRegistered.Name)
+	assert.Equal(t, config.TracesDataType, notRegistered.Kind)
+}
+
+func TestAutoExporterFactoryDelegatesToRegistered(t *testing.T) {
+	var created bool
+	RegisterAutoExporter("fake", config.TracesDataType, newFakeExporterFactory("fake", func(context.Context, component.ExporterCreateSettings, config.Exporter) (component.TracesExporter, error) {
+		created = true
+		return nil, nil
+	}))
+	t.Setenv(envTracesExporter, "fake")
+
+	factory := NewAutoExporterFactory()
+	_, err := factory.CreateTracesExporter(context.Background(), component.ExporterCreateSettings{}, factory.CreateDefaultConfig())
+	require.NoError(t, err)
+	assert.True(t, created)
+}
+
+func TestAutoExporterFactoryFallback(t *testing.T) {
+	var usedFallback bool
+	fallback := newFakeExporterFactory("fallback", func(context.Context, component.ExporterCreateSettings, config.Exporter) (component.TracesExporter, error) {
+		usedFallback = true
+		return nil, nil
+	})
+	t.Setenv(envTracesExporter, "still-not-registered")
+
+	factory := NewAutoExporterFactory(WithFallback(fallback))
+	_, err := factory.CreateTracesExporter(context.Background(), component.ExporterCreateSettings{}, factory.CreateDefaultConfig())
+	require.NoError(t, err)
+	assert.True(t, usedFallback)
+}
+
+func TestAutoExporterFactoryDefaultsToOTLPWhenUnset(t *testing.T) {
+	t.Setenv(envMetricsExporter, "")
+
+	rs := resolve(options{}, config.MetricsDataType)
+	assert.Equal(t, defaultExporterName, rs.name)
+}
@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoexport // import "go.opentelemetry.io/collector/autoexport"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// nopExporter is used for a signal whose environment variable was explicitly
+// set to "none": the pipeline accepts and discards the signal's data instead
+// of failing to find a registered destination for it.
+type nopExporter struct{}
+
+func newNopExporter() nopExporter {
+	return nopExporter{}
+}
+
+func (nopExporter) Start(context.Context, component.Host) error {
+	return nil
+}
+
+func (nopExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+func (nopExporter) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+func (nopExporter) ConsumeTraces(context.Context, ptrace.Traces) error {
+	return nil
+}
+
+func (nopExporter) ConsumeMetrics(context.Context, pmetric.Metrics) error {
+	return nil
+}
+
+func (nopExporter) ConsumeLogs(context.Context, plog.Logs) error {
+	return nil
+}
@@ -0,0 +1,479 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component // import "go.opentelemetry.io/collector/component"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer"
+)
+
+// Connector is a component that sits between two pipelines, consuming the data
+// produced by one and producing the data consumed by the other. It is both an
+// Exporter and a Receiver of telemetry, which lets it bridge pipelines of the
+// same signal (e.g. traces to traces, for routing or fan-out) or different
+// signals (e.g. logs to metrics).
+//
+// Connector implementations should embed one of the nine TracesToTraces,
+// TracesToMetrics, ..., LogsToLogs interfaces, not Connector directly.
+type Connector interface {
+	Component
+}
+
+// TracesToTraces is a Connector that consumes traces and produces traces.
+type TracesToTraces interface {
+	Connector
+	consumer.Traces
+}
+
+// TracesToMetrics is a Connector that consumes traces and produces metrics.
+type TracesToMetrics interface {
+	Connector
+	consumer.Traces
+}
+
+// TracesToLogs is a Connector that consumes traces and produces logs.
+type TracesToLogs interface {
+	Connector
+	consumer.Traces
+}
+
+// MetricsToTraces is a Connector that consumes metrics and produces traces.
+type MetricsToTraces interface {
+	Connector
+	consumer.Metrics
+}
+
+// MetricsToMetrics is a Connector that consumes metrics and produces metrics.
+type MetricsToMetrics interface {
+	Connector
+	consumer.Metrics
+}
+
+// MetricsToLogs is a Connector that consumes metrics and produces logs.
+type MetricsToLogs interface {
+	Connector
+	consumer.Metrics
+}
+
+// LogsToTraces is a Connector that consumes logs and produces traces.
+type LogsToTraces interface {
+	Connector
+	consumer.Logs
+}
+
+// LogsToMetrics is a Connector that consumes logs and produces metrics.
+type LogsToMetrics interface {
+	Connector
+	consumer.Logs
+}
+
+// LogsToLogs is a Connector that consumes logs and produces logs.
+type LogsToLogs interface {
+	Connector
+	consumer.Logs
+}
+
+// ConnectorCreateSettings configures Connector creators.
+type ConnectorCreateSettings struct {
+	TelemetrySettings
+
+	// BuildInfo can be used by components for informational purposes
+	BuildInfo BuildInfo
+}
+
+// ConnectorFactory is factory interface for connectors.
+//
+// This interface cannot be directly implemented. Implementations must
+// use the NewConnectorFactory to implement it.
+type ConnectorFactory interface {
+	Factory
+
+	// CreateDefaultConfig creates the default configuration for the Connector.
+	// This method can be called multiple times depending on the pipeline
+	// configuration and should not cause side-effects that prevent the creation
+	// of multiple instances of the Connector.
+	// The object returned by this method needs to pass the checks implemented by
+	// 'configtest.CheckConfigStruct'. It is recommended to have these checks in the
+	// tests of any implementation of the Factory interface.
+	CreateDefaultConfig() config.Connector
+
+	// CreateTracesToTraces creates a TracesToTraces connector based on this config.
+	// If the connector type does not support this combination, or if the config is
+	// not valid, an error will be returned instead.
+	CreateTracesToTraces(ctx context.Context, set ConnectorCreateSettings, cfg config.Connector) (TracesToTraces, error)
+
+	// TracesToTracesStability gets the stability level of the TracesToTraces connector.
+	TracesToTracesStability() StabilityLevel
+
+	// CreateTracesToMetrics creates a TracesToMetrics connector based on this config.
+	// If the connector type does not support this combination, or if the config is
+	// not valid, an error will be returned instead.
+	CreateTracesToMetrics(ctx context.Context, set ConnectorCreateSettings, cfg config.Connector) (TracesToMetrics, error)
+
+	// TracesToMetricsStability gets the stability level of the TracesToMetrics connector.
+	TracesToMetricsStability() StabilityLevel
+
+	// CreateTracesToLogs creates a TracesToLogs connector based on this config.
+	// If the connector type does not support this combination, or if the config is
+	// not valid, an error will be returned instead.
+	CreateTracesToLogs(ctx context.Context, set ConnectorCreateSettings, cfg config.Connector) (TracesToLogs, error)
+
+	// TracesToLogsStability gets the stability level of the TracesToLogs connector.
+	TracesToLogsStability() StabilityLevel
+
+	// CreateMetricsToTraces creates a MetricsToTraces connector based on this config.
+	// If the connector type does not support this combination, or if the config is
+	// not valid, an error will be returned instead.
+	CreateMetricsToTraces(ctx context.Context, set ConnectorCreateSettings, cfg config.Connector) (MetricsToTraces, error)
+
+	// MetricsToTracesStability gets the stability level of the MetricsToTraces connector.
+	MetricsToTracesStability() StabilityLevel
+
+	// CreateMetricsToMetrics creates a MetricsToMetrics connector based on this config.
+	// If the connector type does not support this combination, or if the config is
+	// not valid, an error will be returned instead.
+	CreateMetricsToMetrics(ctx context.Context, set ConnectorCreateSettings, cfg config.Connector) (MetricsToMetrics, error)
+
+	// MetricsToMetricsStability gets the stability level of the MetricsToMetrics connector.
+	MetricsToMetricsStability() StabilityLevel
+
+	// CreateMetricsToLogs creates a MetricsToLogs connector based on this config.
+	// If the connector type does not support this combination, or if the config is
+	// not valid, an error will be returned instead.
+	CreateMetricsToLogs(ctx context.Context, set ConnectorCreateSettings, cfg config.Connector) (MetricsToLogs, error)
+
+	// MetricsToLogsStability gets the stability level of the MetricsToLogs connector.
+	MetricsToLogsStability() StabilityLevel
+
+	// CreateLogsToTraces creates a LogsToTraces connector based on this config.
+	// If the connector type does not support this combination, or if the config is
+	// not valid, an error will be returned instead.
+	CreateLogsToTraces(ctx context.Context, set ConnectorCreateSettings, cfg config.Connector) (LogsToTraces, error)
+
+	// LogsToTracesStability gets the stability level of the LogsToTraces connector.
+	LogsToTracesStability() StabilityLevel
+
+	// CreateLogsToMetrics creates a LogsToMetrics connector based on this config.
+	// If the connector type does not support this combination, or if the config is
+	// not valid, an error will be returned instead.
+	CreateLogsToMetrics(ctx context.Context, set ConnectorCreateSettings, cfg config.Connector) (LogsToMetrics, error)
+
+	// LogsToMetricsStability gets the stability level of the LogsToMetrics connector.
+	LogsToMetricsStability() StabilityLevel
+
+	// CreateLogsToLogs creates a LogsToLogs connector based on this config.
+	// If the connector type does not support this combination, or if the config is
+	// not valid, an error will be returned instead.
+	CreateLogsToLogs(ctx context.Context, set ConnectorCreateSettings, cfg config.Connector) (LogsToLogs, error)
+
+	// LogsToLogsStability gets the stability level of the LogsToLogs connector.
+	LogsToLogsStability() StabilityLevel
+}
+
+// ConnectorFactoryOption apply changes to ConnectorOptions.
+type ConnectorFactoryOption interface {
+	// applyConnectorFactoryOption applies the option.
+	applyConnectorFactoryOption(o *connectorFactory)
+}
+
+var _ ConnectorFactoryOption = (*connectorFactoryOptionFunc)(nil)
+
+// connectorFactoryOptionFunc is an ConnectorFactoryOption created through a function.
+type connectorFactoryOptionFunc func(*connectorFactory)
+
+func (f connectorFactoryOptionFunc) applyConnectorFactoryOption(o *connectorFactory) {
+	f(o)
+}
+
+// ConnectorCreateDefaultConfigFunc is the equivalent of ConnectorFactory.CreateDefaultConfig().
+type ConnectorCreateDefaultConfigFunc func() config.Connector
+
+// CreateDefaultConfig implements ConnectorFactory.CreateDefaultConfig().
+func (f ConnectorCreateDefaultConfigFunc) CreateDefaultConfig() config.Connector {
+	return f()
+}
+
+// CreateTracesToTracesFunc is the equivalent of ConnectorFactory.CreateTracesToTraces().
+type CreateTracesToTracesFunc func(context.Context, ConnectorCreateSettings, config.Connector) (TracesToTraces, error)
+
+// CreateTracesToTraces implements ConnectorFactory.CreateTracesToTraces().
+func (f CreateTracesToTracesFunc) CreateTracesToTraces(ctx context.Context, set ConnectorCreateSettings, cfg config.Connector) (TracesToTraces, error) {
+	if f == nil {
+		return nil, ErrDataTypeIsNotSupported
+	}
+	return f(ctx, set, cfg)
+}
+
+// CreateTracesToMetricsFunc is the equivalent of ConnectorFactory.CreateTracesToMetrics().
+type CreateTracesToMetricsFunc func(context.Context, ConnectorCreateSettings, config.Connector) (TracesToMetrics, error)
+
+// CreateTracesToMetrics implements ConnectorFactory.CreateTracesToMetrics().
+func (f CreateTracesToMetricsFunc) CreateTracesToMetrics(ctx context.Context, set ConnectorCreateSettings, cfg config.Connector) (TracesToMetrics, error) {
+	if f == nil {
+		return nil, ErrDataTypeIsNotSupported
+	}
+	return f(ctx, set, cfg)
+}
+
+// CreateTracesToLogsFunc is the equivalent of ConnectorFactory.CreateTracesToLogs().
+type CreateTracesToLogsFunc func(context.Context, ConnectorCreateSettings, config.Connector) (TracesToLogs, error)
+
+// CreateTracesToLogs implements ConnectorFactory.CreateTracesToLogs().
+func (f CreateTracesToLogsFunc) CreateTracesToLogs(ctx context.Context, set ConnectorCreateSettings, cfg config.Connector) (TracesToLogs, error) {
+	if f == nil {
+		return nil, ErrDataTypeIsNotSupported
+	}
+	return f(ctx, set, cfg)
+}
+
+// CreateMetricsToTracesFunc is the equivalent of ConnectorFactory.CreateMetricsToTraces().
+type CreateMetricsToTracesFunc func(context.Context, ConnectorCreateSettings, config.Connector) (MetricsToTraces, error)
+
+// CreateMetricsToTraces implements ConnectorFactory.CreateMetricsToTraces().
+func (f CreateMetricsToTracesFunc) CreateMetricsToTraces(ctx context.Context, set ConnectorCreateSettings, cfg config.Connector) (MetricsToTraces, error) {
+	if f == nil {
+		return nil, ErrDataTypeIsNotSupported
+	}
+	return f(ctx, set, cfg)
+}
+
+// CreateMetricsToMetricsFunc is the equivalent of ConnectorFactory.CreateMetricsToMetrics().
+type CreateMetricsToMetricsFunc func(context.Context, ConnectorCreateSettings, config.Connector) (MetricsToMetrics, error)
+
+// CreateMetricsToMetrics implements ConnectorFactory.CreateMetricsToMetrics().
+func (f CreateMetricsToMetricsFunc) CreateMetricsToMetrics(ctx context.Context, set ConnectorCreateSettings, cfg config.Connector) (MetricsToMetrics, error) {
+	if f == nil {
+		return nil, ErrDataTypeIsNotSupported
+	}
+	return f(ctx, set, cfg)
+}
+
+// CreateMetricsToLogsFunc is the equivalent of ConnectorFactory.CreateMetricsToLogs().
+type CreateMetricsToLogsFunc func(context.Context, ConnectorCreateSettings, config.Connector) (MetricsToLogs, error)
+
+// CreateMetricsToLogs implements ConnectorFactory.CreateMetricsToLogs().
+func (f CreateMetricsToLogsFunc) CreateMetricsToLogs(ctx context.Context, set ConnectorCreateSettings, cfg config.Connector) (MetricsToLogs, error) {
+	if f == nil {
+		return nil, ErrDataTypeIsNotSupported
+	}
+	return f(ctx, set, cfg)
+}
+
+// CreateLogsToTracesFunc is the equivalent of ConnectorFactory.CreateLogsToTraces().
+type CreateLogsToTracesFunc func(context.Context, ConnectorCreateSettings, config.Connector) (LogsToTraces, error)
+
+// CreateLogsToTraces implements ConnectorFactory.CreateLogsToTraces().
+func (f CreateLogsToTracesFunc) CreateLogsToTraces(ctx context.Context, set ConnectorCreateSettings, cfg config.Connector) (LogsToTraces, error) {
+	if f == nil {
+		return nil, ErrDataTypeIsNotSupported
+	}
+	return f(ctx, set, cfg)
+}
+
+// CreateLogsToMetricsFunc is the equivalent of ConnectorFactory.CreateLogsToMetrics().
+type CreateLogsToMetricsFunc func(context.Context, ConnectorCreateSettings, config.Connector) (LogsToMetrics, error)
+
+// CreateLogsToMetrics implements ConnectorFactory.CreateLogsToMetrics().
+func (f CreateLogsToMetricsFunc) CreateLogsToMetrics(ctx context.Context, set ConnectorCreateSettings, cfg config.Connector) (LogsToMetrics, error) {
+	if f == nil {
+		return nil, ErrDataTypeIsNotSupported
+	}
+	return f(ctx, set, cfg)
+}
+
+// CreateLogsToLogsFunc is the equivalent of ConnectorFactory.CreateLogsToLogs().
+type CreateLogsToLogsFunc func(context.Context, ConnectorCreateSettings, config.Connector) (LogsToLogs, error)
+
+// CreateLogsToLogs implements ConnectorFactory.CreateLogsToLogs().
+func (f CreateLogsToLogsFunc) CreateLogsToLogs(ctx context.Context, set ConnectorCreateSettings, cfg config.Connector) (LogsToLogs, error) {
+	if f == nil {
+		return nil, ErrDataTypeIsNotSupported
+	}
+	return f(ctx, set, cfg)
+}
+
+type connectorFactory struct {
+	// baseFactory supplies Type() and the unexported marker method that makes Factory
+	// "cannot be directly implemented", matching exporterFactory/receiverFactory/
+	// processorFactory. Its stability map is keyed by a single config.DataType, which
+	// cannot express the nine (input, output) pairs a connector needs, so per-pair
+	// stability is tracked separately below instead of through baseFactory.stability.
+	baseFactory
+	ConnectorCreateDefaultConfigFunc
+	CreateTracesToTracesFunc
+	CreateTracesToMetricsFunc
+	CreateTracesToLogsFunc
+	CreateMetricsToTracesFunc
+	CreateMetricsToMetricsFunc
+	CreateMetricsToLogsFunc
+	CreateLogsToTracesFunc
+	CreateLogsToMetricsFunc
+	CreateLogsToLogsFunc
+
+	tracesToTracesStability   StabilityLevel
+	tracesToMetricsStability  StabilityLevel
+	tracesToLogsStability     StabilityLevel
+	metricsToTracesStability  StabilityLevel
+	metricsToMetricsStability StabilityLevel
+	metricsToLogsStability    StabilityLevel
+	logsToTracesStability     StabilityLevel
+	logsToMetricsStability    StabilityLevel
+	logsToLogsStability       StabilityLevel
+}
+
+func (f *connectorFactory) TracesToTracesStability() StabilityLevel {
+	return f.tracesToTracesStability
+}
+
+func (f *connectorFactory) TracesToMetricsStability() StabilityLevel {
+	return f.tracesToMetricsStability
+}
+
+func (f *connectorFactory) TracesToLogsStability() StabilityLevel {
+	return f.tracesToLogsStability
+}
+
+func (f *connectorFactory) MetricsToTracesStability() StabilityLevel {
+	return f.metricsToTracesStability
+}
+
+func (f *connectorFactory) MetricsToMetricsStability() StabilityLevel {
+	return f.metricsToMetricsStability
+}
+
+func (f *connectorFactory) MetricsToLogsStability() StabilityLevel {
+	return f.metricsToLogsStability
+}
+
+func (f *connectorFactory) LogsToTracesStability() StabilityLevel {
+	return f.logsToTracesStability
+}
+
+func (f *connectorFactory) LogsToMetricsStability() StabilityLevel {
+	return f.logsToMetricsStability
+}
+
+func (f *connectorFactory) LogsToLogsStability() StabilityLevel {
+	return f.logsToLogsStability
+}
+
+// WithTracesToTracesConnector overrides the default "error not supported" implementation for
+// CreateTracesToTraces and the default "undefined" stability level.
+func WithTracesToTracesConnector(createTracesToTraces CreateTracesToTracesFunc, sl StabilityLevel) ConnectorFactoryOption {
+	return connectorFactoryOptionFunc(func(o *connectorFactory) {
+		o.tracesToTracesStability = sl
+		o.CreateTracesToTracesFunc = createTracesToTraces
+	})
+}
+
+// WithTracesToMetricsConnector overrides the default "error not supported" implementation for
+// CreateTracesToMetrics and the default "undefined" stability level.
+func WithTracesToMetricsConnector(createTracesToMetrics CreateTracesToMetricsFunc, sl StabilityLevel) ConnectorFactoryOption {
+	return connectorFactoryOptionFunc(func(o *connectorFactory) {
+		o.tracesToMetricsStability = sl
+		o.CreateTracesToMetricsFunc = createTracesToMetrics
+	})
+}
+
+// WithTracesToLogsConnector overrides the default "error not supported" implementation for
+// CreateTracesToLogs and the default "undefined" stability level.
+func WithTracesToLogsConnector(createTracesToLogs CreateTracesToLogsFunc, sl StabilityLevel) ConnectorFactoryOption {
+	return connectorFactoryOptionFunc(func(o *connectorFactory) {
+		o.tracesToLogsStability = sl
+		o.CreateTracesToLogsFunc = createTracesToLogs
+	})
+}
+
+// WithMetricsToTracesConnector overrides the default "error not supported" implementation for
+// CreateMetricsToTraces and the default "undefined" stability level.
+func WithMetricsToTracesConnector(createMetricsToTraces CreateMetricsToTracesFunc, sl StabilityLevel) ConnectorFactoryOption {
+	return connectorFactoryOptionFunc(func(o *connectorFactory) {
+		o.metricsToTracesStability = sl
+		o.CreateMetricsToTracesFunc = createMetricsToTraces
+	})
+}
+
+// WithMetricsToMetricsConnector overrides the default "error not supported" implementation for
+// CreateMetricsToMetrics and the default "undefined" stability level.
+func WithMetricsToMetricsConnector(createMetricsToMetrics CreateMetricsToMetricsFunc, sl StabilityLevel) ConnectorFactoryOption {
+	return connectorFactoryOptionFunc(func(o *connectorFactory) {
+		o.metricsToMetricsStability = sl
+		o.CreateMetricsToMetricsFunc = createMetricsToMetrics
+	})
+}
+
+// WithMetricsToLogsConnector overrides the default "error not supported" implementation for
+// CreateMetricsToLogs and the default "undefined" stability level.
+func WithMetricsToLogsConnector(createMetricsToLogs CreateMetricsToLogsFunc, sl StabilityLevel) ConnectorFactoryOption {
+	return connectorFactoryOptionFunc(func(o *connectorFactory) {
+		o.metricsToLogsStability = sl
+		o.CreateMetricsToLogsFunc = createMetricsToLogs
+	})
+}
+
+// WithLogsToTracesConnector overrides the default "error not supported" implementation for
+// CreateLogsToTraces and the default "undefined" stability level.
+func WithLogsToTracesConnector(createLogsToTraces CreateLogsToTracesFunc, sl StabilityLevel) ConnectorFactoryOption {
+	return connectorFactoryOptionFunc(func(o *connectorFactory) {
+		o.logsToTracesStability = sl
+		o.CreateLogsToTracesFunc = createLogsToTraces
+	})
+}
+
+// WithLogsToMetricsConnector overrides the default "error not supported" implementation for
+// CreateLogsToMetrics and the default "undefined" stability level.
+func WithLogsToMetricsConnector(createLogsToMetrics CreateLogsToMetricsFunc, sl StabilityLevel) ConnectorFactoryOption {
+	return connectorFactoryOptionFunc(func(o *connectorFactory) {
+		o.logsToMetricsStability = sl
+		o.CreateLogsToMetricsFunc = createLogsToMetrics
+	})
+}
+
+// WithLogsToLogsConnector overrides the default "error not supported" implementation for
+// CreateLogsToLogs and the default "undefined" stability level.
+func WithLogsToLogsConnector(createLogsToLogs CreateLogsToLogsFunc, sl StabilityLevel) ConnectorFactoryOption {
+	return connectorFactoryOptionFunc(func(o *connectorFactory) {
+		o.logsToLogsStability = sl
+		o.CreateLogsToLogsFunc = createLogsToLogs
+	})
+}
+
+// NewConnectorFactory returns a ConnectorFactory.
+func NewConnectorFactory(cfgType config.Type, createDefaultConfig ConnectorCreateDefaultConfigFunc, options ...ConnectorFactoryOption) ConnectorFactory {
+	f := &connectorFactory{
+		baseFactory:                      baseFactory{cfgType: cfgType, stability: make(map[config.DataType]StabilityLevel)},
+		ConnectorCreateDefaultConfigFunc: createDefaultConfig,
+	}
+	for _, opt := range options {
+		opt.applyConnectorFactoryOption(f)
+	}
+	return f
+}
+
+// MakeConnectorFactoryMap takes a list of connector factories and returns a map with factory type as
+// key and factory as value. It returns an error if there are factories with duplicate type.
+func MakeConnectorFactoryMap(factories ...ConnectorFactory) (map[config.Type]ConnectorFactory, error) {
+	fMap := map[config.Type]ConnectorFactory{}
+	for _, f := range factories {
+		if _, ok := fMap[f.Type()]; ok {
+			return nil, fmt.Errorf("duplicate connector factory %q", f.Type())
+		}
+		fMap[f.Type()] = f
+	}
+	return fMap, nil
+}
@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+type nopTracesToTracesConnector struct{}
+
+func (nopTracesToTracesConnector) Start(context.Context, Host) error { return nil }
+
+func (nopTracesToTracesConnector) Shutdown(context.Context) error { return nil }
+
+func (nopTracesToTracesConnector) Capabilities() consumer.Capabilities { return consumer.Capabilities{} }
+
+func (nopTracesToTracesConnector) ConsumeTraces(context.Context, ptrace.Traces) error { return nil }
+
+func TestNewConnectorFactory(t *testing.T) {
+	var onTracesToTracesCalled bool
+	factory := NewConnectorFactory(
+		"test",
+		func() config.Connector {
+			cfg := config.NewConnectorSettings(config.NewComponentID("test"))
+			return &cfg
+		},
+		WithTracesToTracesConnector(
+			func(context.Context, ConnectorCreateSettings, config.Connector) (TracesToTraces, error) {
+				onTracesToTracesCalled = true
+				return nopTracesToTracesConnector{}, nil
+			},
+			StabilityLevelBeta,
+		),
+	)
+
+	assert.EqualValues(t, "test", factory.Type())
+	assert.Equal(t, StabilityLevelBeta, factory.TracesToTracesStability())
+	assert.Equal(t, StabilityLevelUndefined, factory.TracesToMetricsStability())
+
+	conn, err := factory.CreateTracesToTraces(context.Background(), ConnectorCreateSettings{}, factory.CreateDefaultConfig())
+	require.NoError(t, err)
+	assert.NotNil(t, conn)
+	assert.True(t, onTracesToTracesCalled)
+
+	_, err = factory.CreateTracesToMetrics(context.Background(), ConnectorCreateSettings{}, factory.CreateDefaultConfig())
+	require.ErrorIs(t, err, ErrDataTypeIsNotSupported)
+}
+
+func TestMakeConnectorFactoryMap(t *testing.T) {
+	type testCase struct {
+		name      string
+		factories []ConnectorFactory
+		wantErr   bool
+	}
+
+	newFactory := func(cfgType config.Type) ConnectorFactory {
+		return NewConnectorFactory(cfgType, func() config.Connector {
+			cfg := config.NewConnectorSettings(config.NewComponentID(cfgType))
+			return &cfg
+		})
+	}
+
+	tests := []testCase{
+		{
+			name:      "distinct types",
+			factories: []ConnectorFactory{newFactory("a"), newFactory("b")},
+			wantErr:   false,
+		},
+		{
+			name:      "duplicate type",
+			factories: []ConnectorFactory{newFactory("a"), newFactory("a")},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := MakeConnectorFactoryMap(tt.factories...)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, out, len(tt.factories))
+		})
+	}
+}
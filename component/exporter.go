@@ -16,6 +16,10 @@ package component // import "go.opentelemetry.io/collector/component"
 
 import (
 	"context"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
 
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/consumer"
@@ -50,6 +54,81 @@ type ExporterCreateSettings struct {
 
 	// BuildInfo can be used by components for informational purposes
 	BuildInfo BuildInfo
+
+	// MinStabilityLevel is the lowest StabilityLevel, per signal, that an exporter
+	// may be constructed at. It is populated by the service from a top-level
+	// service.telemetry.min_stability config knob. A zero value (StabilityLevelUndefined)
+	// disables the check, so every stability level is allowed.
+	//
+	// Only exporter creation is gated by this setting: ConnectorCreateSettings has no
+	// equivalent field, and receiver and processor creation is not checked against a
+	// minimum stability level.
+	MinStabilityLevel StabilityLevel
+}
+
+// ErrBelowMinStability is returned by CreateTracesExporter, CreateMetricsExporter and
+// CreateLogsExporter when the StabilityLevel the factory declares for the requested
+// signal is lower than ExporterCreateSettings.MinStabilityLevel.
+type ErrBelowMinStability struct {
+	Signal   config.DataType
+	Have     StabilityLevel
+	Required StabilityLevel
+}
+
+func (e *ErrBelowMinStability) Error() string {
+	return fmt.Sprintf("%s exporter has stability level %q, which is below the required minimum %q",
+		e.Signal, e.Have, e.Required)
+}
+
+// stabilityRank orders StabilityLevel from least to most stable so that it can be
+// compared against ExporterCreateSettings.MinStabilityLevel. Levels not explicitly
+// listed are treated as StabilityLevelUndefined.
+func stabilityRank(l StabilityLevel) int {
+	switch l {
+	case StabilityLevelDeprecated:
+		return 1
+	case StabilityLevelAlpha:
+		return 2
+	case StabilityLevelBeta:
+		return 3
+	case StabilityLevelStable:
+		return 4
+	default:
+		return 0
+	}
+}
+
+// checkMinStability returns ErrBelowMinStability if have is less stable than min.
+// A min of StabilityLevelUndefined disables the check.
+func checkMinStability(signal config.DataType, have, min StabilityLevel) error {
+	if min == StabilityLevelUndefined || stabilityRank(have) >= stabilityRank(min) {
+		return nil
+	}
+	return &ErrBelowMinStability{Signal: signal, Have: have, Required: min}
+}
+
+// warnIfUnstable logs a one-shot warning through set.Logger when level is
+// StabilityLevelDeprecated or StabilityLevelAlpha, so that operators notice before
+// they hit the min-stability gate. warned deduplicates the warning per component ID
+// and signal; it is scoped to a single exporterFactory instance (see
+// exporterFactory.warned) rather than the process, so it does not leak across
+// factories or outlive the collector/service instance that created it.
+func warnIfUnstable(warned *sync.Map, set ExporterCreateSettings, id config.ComponentID, signal config.DataType, level StabilityLevel) {
+	if level != StabilityLevelDeprecated && level != StabilityLevelAlpha {
+		return
+	}
+	if set.Logger == nil {
+		return
+	}
+	key := id.String() + "/" + string(signal)
+	if _, alreadyWarned := warned.LoadOrStore(key, struct{}{}); alreadyWarned {
+		return
+	}
+	set.Logger.Warn("Exporter stability level is not stable",
+		zap.String("id", id.String()),
+		zap.String("signal", string(signal)),
+		zap.String("stability", string(level)),
+	)
 }
 
 // ExporterFactory is factory interface for exporters.
@@ -155,6 +234,12 @@ type exporterFactory struct {
 	CreateTracesExporterFunc
 	CreateMetricsExporterFunc
 	CreateLogsExporterFunc
+
+	// warned deduplicates the one-shot deprecation/alpha warning logged by
+	// warnIfUnstable. It is scoped to this factory instance, not a package
+	// global, so distinct factories (and distinct collector/service instances
+	// built in the same process, e.g. in tests) warn independently.
+	warned *sync.Map
 }
 
 func (e exporterFactory) TracesExporterStability() StabilityLevel {
@@ -169,6 +254,51 @@ func (e exporterFactory) LogsExporterStability() StabilityLevel {
 	return e.getStabilityLevel(config.LogsDataType)
 }
 
+// CreateTracesExporter implements ExporterFactory.CreateTracesExporter(), enforcing
+// set.MinStabilityLevel and logging a one-shot warning for unstable exporters before
+// delegating to the registered CreateTracesExporterFunc.
+func (e exporterFactory) CreateTracesExporter(ctx context.Context, set ExporterCreateSettings, cfg config.Exporter) (TracesExporter, error) {
+	if e.CreateTracesExporterFunc == nil {
+		return nil, ErrDataTypeIsNotSupported
+	}
+	level := e.TracesExporterStability()
+	if err := checkMinStability(config.TracesDataType, level, set.MinStabilityLevel); err != nil {
+		return nil, err
+	}
+	warnIfUnstable(e.warned, set, cfg.ID(), config.TracesDataType, level)
+	return e.CreateTracesExporterFunc(ctx, set, cfg)
+}
+
+// CreateMetricsExporter implements ExporterFactory.CreateMetricsExporter(), enforcing
+// set.MinStabilityLevel and logging a one-shot warning for unstable exporters before
+// delegating to the registered CreateMetricsExporterFunc.
+func (e exporterFactory) CreateMetricsExporter(ctx context.Context, set ExporterCreateSettings, cfg config.Exporter) (MetricsExporter, error) {
+	if e.CreateMetricsExporterFunc == nil {
+		return nil, ErrDataTypeIsNotSupported
+	}
+	level := e.MetricsExporterStability()
+	if err := checkMinStability(config.MetricsDataType, level, set.MinStabilityLevel); err != nil {
+		return nil, err
+	}
+	warnIfUnstable(e.warned, set, cfg.ID(), config.MetricsDataType, level)
+	return e.CreateMetricsExporterFunc(ctx, set, cfg)
+}
+
+// CreateLogsExporter implements ExporterFactory.CreateLogsExporter(), enforcing
+// set.MinStabilityLevel and logging a one-shot warning for unstable exporters before
+// delegating to the registered CreateLogsExporterFunc.
+func (e exporterFactory) CreateLogsExporter(ctx context.Context, set ExporterCreateSettings, cfg config.Exporter) (LogsExporter, error) {
+	if e.CreateLogsExporterFunc == nil {
+		return nil, ErrDataTypeIsNotSupported
+	}
+	level := e.LogsExporterStability()
+	if err := checkMinStability(config.LogsDataType, level, set.MinStabilityLevel); err != nil {
+		return nil, err
+	}
+	warnIfUnstable(e.warned, set, cfg.ID(), config.LogsDataType, level)
+	return e.CreateLogsExporterFunc(ctx, set, cfg)
+}
+
 // WithTracesExporter overrides the default "error not supported" implementation for CreateTracesExporter and the default "undefined" stability level.
 func WithTracesExporter(createTracesExporter CreateTracesExporterFunc, sl StabilityLevel) ExporterFactoryOption {
 	return exporterFactoryOptionFunc(func(o *exporterFactory) {
@@ -198,6 +328,7 @@ func NewExporterFactory(cfgType config.Type, createDefaultConfig ExporterCreateD
 	f := &exporterFactory{
 		baseFactory:                     baseFactory{cfgType: cfgType, stability: make(map[config.DataType]StabilityLevel)},
 		ExporterCreateDefaultConfigFunc: createDefaultConfig,
+		warned:                          &sync.Map{},
 	}
 	for _, opt := range options {
 		opt.applyExporterFactoryOption(f)
@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+type nopTracesExporter struct{}
+
+func (nopTracesExporter) Start(context.Context, Host) error { return nil }
+
+func (nopTracesExporter) Shutdown(context.Context) error { return nil }
+
+func (nopTracesExporter) Capabilities() consumer.Capabilities { return consumer.Capabilities{} }
+
+func (nopTracesExporter) ConsumeTraces(context.Context, ptrace.Traces) error { return nil }
+
+type exporterTestConfig struct {
+	id config.ComponentID
+}
+
+func (c exporterTestConfig) ID() config.ComponentID { return c.id }
+
+func (c exporterTestConfig) Validate() error { return nil }
+
+func newExporterTestConfig() config.Exporter {
+	return exporterTestConfig{id: config.NewComponentID("test")}
+}
+
+func TestCheckMinStability(t *testing.T) {
+	tests := []struct {
+		name    string
+		have    StabilityLevel
+		min     StabilityLevel
+		wantErr bool
+	}{
+		{name: "undefined min allows anything", have: StabilityLevelAlpha, min: StabilityLevelUndefined, wantErr: false},
+		{name: "equal to min passes", have: StabilityLevelBeta, min: StabilityLevelBeta, wantErr: false},
+		{name: "above min passes", have: StabilityLevelStable, min: StabilityLevelBeta, wantErr: false},
+		{name: "below min fails", have: StabilityLevelAlpha, min: StabilityLevelBeta, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkMinStability(config.TracesDataType, tt.have, tt.min)
+			if !tt.wantErr {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			var belowErr *ErrBelowMinStability
+			require.ErrorAs(t, err, &belowErr)
+			assert.Equal(t, config.TracesDataType, belowErr.Signal)
+			assert.Equal(t, tt.have, belowErr.Have)
+			assert.Equal(t, tt.min, belowErr.Required)
+		})
+	}
+}
+
+func TestCreateTracesExporterBelowMinStability(t *testing.T) {
+	factory := NewExporterFactory(
+		"test",
+		newExporterTestConfig,
+		WithTracesExporter(
+			func(context.Context, ExporterCreateSettings, config.Exporter) (TracesExporter, error) {
+				return nopTracesExporter{}, nil
+			},
+			StabilityLevelAlpha,
+		),
+	)
+
+	_, err := factory.CreateTracesExporter(context.Background(), ExporterCreateSettings{MinStabilityLevel: StabilityLevelBeta}, factory.CreateDefaultConfig())
+	require.Error(t, err)
+	var belowErr *ErrBelowMinStability
+	require.ErrorAs(t, err, &belowErr)
+}
+
+func TestCreateTracesExporterAtOrAboveMinStabilitySucceeds(t *testing.T) {
+	factory := NewExporterFactory(
+		"test",
+		newExporterTestConfig,
+		WithTracesExporter(
+			func(context.Context, ExporterCreateSettings, config.Exporter) (TracesExporter, error) {
+				return nopTracesExporter{}, nil
+			},
+			StabilityLevelAlpha,
+		),
+	)
+
+	exp, err := factory.CreateTracesExporter(context.Background(), ExporterCreateSettings{MinStabilityLevel: StabilityLevelAlpha}, factory.CreateDefaultConfig())
+	require.NoError(t, err)
+	assert.NotNil(t, exp)
+}
+
+func TestCreateExporterNotSupported(t *testing.T) {
+	factory := NewExporterFactory("test", newExporterTestConfig)
+
+	_, err := factory.CreateMetricsExporter(context.Background(), ExporterCreateSettings{}, factory.CreateDefaultConfig())
+	require.ErrorIs(t, err, ErrDataTypeIsNotSupported)
+}
+
+func TestWarnIfUnstableNilLoggerDoesNotPanic(t *testing.T) {
+	assert.NotPanics(t, func() {
+		warnIfUnstable(&sync.Map{}, ExporterCreateSettings{}, config.NewComponentID("test"), config.TracesDataType, StabilityLevelAlpha)
+	})
+}
+
+func TestWarnIfUnstableIsOneShot(t *testing.T) {
+	core, recorded := observer.New(zap.DebugLevel)
+	set := ExporterCreateSettings{TelemetrySettings: TelemetrySettings{Logger: zap.New(core)}}
+	warned := &sync.Map{}
+	id := config.NewComponentID("test")
+
+	warnIfUnstable(warned, set, id, config.TracesDataType, StabilityLevelAlpha)
+	warnIfUnstable(warned, set, id, config.TracesDataType, StabilityLevelAlpha)
+
+	assert.Len(t, recorded.All(), 1)
+}
+
+func TestWarnIfUnstableSkipsStableAndBeta(t *testing.T) {
+	core, recorded := observer.New(zap.DebugLevel)
+	set := ExporterCreateSettings{TelemetrySettings: TelemetrySettings{Logger: zap.New(core)}}
+	warned := &sync.Map{}
+	id := config.NewComponentID("test")
+
+	warnIfUnstable(warned, set, id, config.TracesDataType, StabilityLevelBeta)
+	warnIfUnstable(warned, set, id, config.TracesDataType, StabilityLevelStable)
+
+	assert.Empty(t, recorded.All())
+}
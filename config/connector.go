@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config // import "go.opentelemetry.io/collector/config"
+
+// Connector is the configuration of a connector. Specific connectors must implement this
+// interface and must embed ConnectorSettings struct or a struct that extends it.
+type Connector interface {
+	// ID returns the ID of the component that this configuration belongs to.
+	ID() ComponentID
+
+	// Validate validates the configuration and returns an error if invalid.
+	Validate() error
+}
+
+// ConnectorSettings defines common settings for a connector configuration.
+// Specific connectors can embed this struct and extend it with more fields if needed.
+type ConnectorSettings struct {
+	id ComponentID `mapstructure:"-"`
+}
+
+// NewConnectorSettings returns a new ConnectorSettings with the given ComponentID.
+func NewConnectorSettings(id ComponentID) ConnectorSettings {
+	return ConnectorSettings{id: id}
+}
+
+var _ Connector = (*ConnectorSettings)(nil)
+
+// ID returns the ID of the component that this configuration belongs to.
+func (cs *ConnectorSettings) ID() ComponentID {
+	return cs.id
+}
+
+// Validate validates the configuration and returns an error if invalid.
+func (cs *ConnectorSettings) Validate() error {
+	return nil
+}